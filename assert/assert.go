@@ -1,29 +1,44 @@
 package assert
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
+
+	"github.com/PlayerR9/go-debug/assert/cmp"
 )
 
-// Assert panics if cond is false. It is intended to be used for debugging.
+// Assert panics if cond is falsy. It is intended to be used for debugging.
+//
+// cond may be a bool (the original behavior, preserved), or a
+// cmp.Comparison/cmp.Result/error, in which case msg is ignored and the
+// panic message is built from the comparison's own cmp.Result.FailureMessage
+// or from the error itself.
 //
 // Parameters:
 //   - cond: the condition to check.
-//   - msg: the message to print if the condition is false.
+//   - msg: the message to print if cond is a bool and is false.
 //
 // Example:
 //
 //	foo := "foo"
 //	Assert(foo == "bar", "foo is not \"bar\"") // panics: "[ASSERT FAILED]: foo is not \"bar\""
-func Assert(cond bool, msg string) {
-	if cond {
+//	Assert(cmp.Equal(foo, "bar"), "")           // panics: "[ASSERT FAILED] <file>:<line> in <pkg.Func>: got foo, want bar"
+func Assert(cond any, msg string) {
+	ok, code, failMsg, cause := evalCond(cond, msg)
+	if ok {
 		return
 	}
 
-	panic(NewErrAssertFailed(msg))
+	panic(newErrAssertFailed(1, code, failMsg, cause))
 }
 
-// AssertF panics if cond is false. It is intended to be used for debugging.
+// AssertF panics if cond is falsy. It is intended to be used for debugging.
+//
+// cond may be a bool (the original behavior, preserved), or a
+// cmp.Comparison/cmp.Result/error, in which case format and args are
+// ignored and the panic message is built from the comparison's own
+// cmp.Result.FailureMessage or from the error itself.
 //
 // Parameters:
 //   - cond: the condition to check.
@@ -35,14 +50,46 @@ func Assert(cond bool, msg string) {
 //	foo := "foo"
 //	bar := "bar"
 //	AssertF(foo == bar, "%q is not %q", foo, bar) // panics: "[ASSERT FAILED]: \"foo\"" is not \"bar\""
-func AssertF(cond bool, format string, args ...any) {
-	if cond {
+func AssertF(cond any, format string, args ...any) {
+	ok, code, failMsg, cause := evalCond(cond, fmt.Sprintf(format, args...))
+	if ok {
 		return
 	}
 
-	msg := fmt.Sprintf(format, args...)
+	panic(newErrAssertFailed(1, code, failMsg, cause))
+}
+
+// evalCond evaluates cond, which may be a bool, a cmp.Comparison, a
+// cmp.Result, or an error, and returns whether it succeeded, the AssertCode
+// and message to use on failure, and (when cond is an error) that error as
+// the failure's cause. msg is only used when cond is a bool.
+func evalCond(cond any, msg string) (ok bool, code AssertCode, failMsg string, cause error) {
+	if cond == nil {
+		return true, CodeCond, "", nil
+	}
+
+	switch v := cond.(type) {
+	case bool:
+		return v, CodeCond, msg, nil
+	case cmp.Comparison:
+		return evalResult(v())
+	case cmp.Result:
+		return evalResult(v)
+	case error:
+		return false, CodeErr, v.Error(), v
+	default:
+		return false, CodeCond, fmt.Sprintf("Assert: unsupported condition type %T", cond), nil
+	}
+}
+
+// evalResult extracts the (ok, code, message, cause) values from a
+// cmp.Result. The cause is always nil.
+func evalResult(res cmp.Result) (bool, AssertCode, string, error) {
+	if res.Success() {
+		return true, CodeCond, "", nil
+	}
 
-	panic(NewErrAssertFailed(msg))
+	return false, CodeCond, res.FailureMessage(), nil
 }
 
 // AssertOk panics if v is false. It is intended to be used for debugging.
@@ -63,9 +110,7 @@ func AssertOk(ok bool, format string, args ...any) {
 		return
 	}
 
-	msg := fmt.Sprintf(format, args...) + " = false"
-
-	panic(NewErrAssertFailed(msg))
+	panic(newErrAssertFailed(1, CodeCond, okMsg(format, args), nil))
 }
 
 // AssertOk panics if v is true. It is intended to be used for debugging.
@@ -86,9 +131,7 @@ func AssertNotOk(ok bool, format string, args ...any) {
 		return
 	}
 
-	msg := fmt.Sprintf(format, args...) + " = true"
-
-	panic(NewErrAssertFailed(msg))
+	panic(newErrAssertFailed(1, CodeCond, notOkMsg(format, args), nil))
 }
 
 // AssertErr panics if err is not nil. It is intended to be used for debugging.
@@ -109,9 +152,44 @@ func AssertErr(err error, format string, args ...any) {
 		return
 	}
 
-	msg := fmt.Sprintf(format, args...) + " = " + err.Error()
+	panic(newErrAssertFailed(1, CodeErr, ErrMsg(format, args, err), err))
+}
+
+// AssertIs panics if err does not match target, as reported by errors.Is.
+// The resulting ErrAssertFailed wraps err as its Cause, so the original
+// error chain survives the panic.
+//
+// Parameters:
+//   - err: the error to check.
+//   - target: the error err is expected to match.
+//   - name: the name of the check, used in the failure message.
+func AssertIs(err, target error, name string) {
+	if errors.Is(err, target) {
+		return
+	}
+
+	msg := fmt.Sprintf("%s: %v does not match target %v", strconv.Quote(name), err, target)
+
+	panic(newErrAssertFailed(1, CodeErr, msg, err))
+}
+
+// AssertAs panics if err cannot be assigned to target, as reported by
+// errors.As. The resulting ErrAssertFailed wraps err as its Cause, so the
+// original error chain survives the panic.
+//
+// Parameters:
+//   - err: the error to check.
+//   - target: a non-nil pointer to the type err is expected to be
+//     assignable to.
+//   - name: the name of the check, used in the failure message.
+func AssertAs(err error, target any, name string) {
+	if errors.As(err, target) {
+		return
+	}
+
+	msg := fmt.Sprintf("%s: %v is not assignable to %T", strconv.Quote(name), err, target)
 
-	panic(NewErrAssertFailed(msg))
+	panic(newErrAssertFailed(1, CodeErr, msg, err))
 }
 
 // AssertNotNil panics if v is nil. It is intended to be used for debugging.
@@ -124,14 +202,14 @@ func AssertNotNil(v any, name string) {
 		return
 	}
 
-	panic(NewErrAssertFailed(strconv.Quote(name) + " must not be nil"))
+	panic(newErrAssertFailed(1, CodeNil, NotNilMsg(name), nil))
 }
 
 // TODO writes a panic message indicating that a case has not been handled yet.
 //
 // This function is intended to be used as a placeholder until the case is handled.
 func TODO() {
-	panic("TODO: Handle this case")
+	panic(newErrAssertFailed(1, CodeTODO, "TODO: Handle this case", nil))
 }
 
 // AssertDeref tries to dereference an element and panics if it is nil.
@@ -147,17 +225,7 @@ func AssertDeref[T any](elem *T, is_param bool, name string) T {
 		return *elem
 	}
 
-	var msg string
-
-	if is_param {
-		msg = "parameter (" + name + ")"
-	} else {
-		msg = "variable (" + name + ")"
-	}
-
-	msg += " expected to not be nil"
-
-	panic(NewErrAssertFailed(msg))
+	panic(newErrAssertFailed(1, CodeDeref, DerefMsg(is_param, name), nil))
 }
 
 // AssertTypeOf panics if the element is not of the expected type.
@@ -169,9 +237,7 @@ func AssertDeref[T any](elem *T, is_param bool, name string) T {
 func AssertTypeOf[T any](elem any, target string, allow_nil bool) {
 	if elem == nil {
 		if !allow_nil {
-			msg := fmt.Sprintf("expected %q to be of type %T, got nil instead", target, *new(T))
-
-			panic(NewErrAssertFailed(msg))
+			panic(newErrAssertFailed(1, CodeType, TypeOfNilMsg(target, *new(T)), nil))
 		}
 
 		return
@@ -179,9 +245,7 @@ func AssertTypeOf[T any](elem any, target string, allow_nil bool) {
 
 	_, ok := elem.(T)
 	if !ok {
-		msg := fmt.Sprintf("expected %q to be of type %T, got %T instead", target, *new(T), elem)
-
-		panic(NewErrAssertFailed(msg))
+		panic(newErrAssertFailed(1, CodeType, TypeOfMsg(target, *new(T), elem), nil))
 	}
 }
 
@@ -195,16 +259,12 @@ func AssertTypeOf[T any](elem any, target string, allow_nil bool) {
 //   - T: the converted element.
 func AssertConv[T any](elem any, target string) T {
 	if elem == nil {
-		msg := fmt.Sprintf("expected %q to be of type %T, got nil instead", target, *new(T))
-
-		panic(NewErrAssertFailed(msg))
+		panic(newErrAssertFailed(1, CodeType, TypeOfNilMsg(target, *new(T)), nil))
 	}
 
 	res, ok := elem.(T)
 	if !ok {
-		msg := fmt.Sprintf("expected %q to be of type %T, got %T instead", target, *new(T), elem)
-
-		panic(NewErrAssertFailed(msg))
+		panic(newErrAssertFailed(1, CodeType, TypeOfMsg(target, *new(T), elem), nil))
 	}
 
 	return res
@@ -0,0 +1,55 @@
+package assert
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/PlayerR9/go-debug/assert/cmp"
+)
+
+func TestAssertBool(t *testing.T) {
+	Assert(true, "unused")
+
+	mustPanic(t, func() { Assert(false, "boom") })
+}
+
+func TestAssertComparison(t *testing.T) {
+	Assert(cmp.Equal(1, 1), "unused")
+
+	mustPanic(t, func() { Assert(cmp.Equal(1, 2), "unused") })
+}
+
+func TestAssertResult(t *testing.T) {
+	Assert(cmp.ResultSuccess(), "unused")
+
+	mustPanic(t, func() { Assert(cmp.ResultFailure("boom"), "unused") })
+}
+
+func nilErr() error {
+	return nil
+}
+
+func TestAssertError(t *testing.T) {
+	Assert(nilErr(), "unused")
+
+	cause := errors.New("boom")
+
+	defer func() {
+		r := recover()
+
+		err, ok := r.(error)
+		if !ok {
+			t.Fatalf("recovered %v (%T), want an error wrapping %v", r, r, cause)
+		}
+
+		if !errors.Is(err, cause) {
+			t.Fatalf("recovered error %v does not wrap %v", err, cause)
+		}
+	}()
+
+	Assert(cause, "unused")
+}
+
+func TestAssertUnsupportedType(t *testing.T) {
+	mustPanic(t, func() { Assert(42, "unused") })
+}
@@ -0,0 +1,261 @@
+// Package check mirrors the panic-based helpers in the parent assert
+// package, but reports failures to a *testing.T (or any compatible type)
+// instead of panicking. It lives in its own package because its Check/Assert
+// names would otherwise collide with the assert.Assert/AssertF already
+// exported by the parent package.
+package check
+
+import (
+	"fmt"
+
+	"github.com/PlayerR9/go-debug/assert"
+)
+
+// T is the subset of *testing.T that this package needs. It is satisfied by
+// *testing.T and *testing.B, and can be implemented by hand for use outside
+// of the standard testing package.
+type T interface {
+	Helper()
+	Log(args ...any)
+	Fail()
+	FailNow()
+}
+
+// Check reports cond as a failure on t and returns false if cond is false.
+// Unlike Assert, it lets the calling test continue by calling t.Fail()
+// instead of t.FailNow().
+//
+// Parameters:
+//   - t: the test to report the failure on.
+//   - cond: the condition to check.
+//   - msg: the message to log if the condition is false.
+//
+// Returns:
+//   - bool: true if cond is true, false otherwise.
+func Check(t T, cond bool, msg string) bool {
+	t.Helper()
+
+	if cond {
+		return true
+	}
+
+	t.Log(assert.NewErrAssertFailed(msg).Error())
+	t.Fail()
+
+	return false
+}
+
+// CheckF is like Check but formats its message from format and args.
+func CheckF(t T, cond bool, format string, args ...any) bool {
+	t.Helper()
+
+	return Check(t, cond, fmt.Sprintf(format, args...))
+}
+
+// CheckErr reports err as a failure on t if it is not nil.
+//
+// Parameters:
+//   - t: the test to report the failure on.
+//   - err: the error to check.
+//   - format: the format of the message to log if err is not nil.
+//   - args: the arguments of the format.
+//
+// Returns:
+//   - bool: true if err is nil, false otherwise.
+func CheckErr(t T, err error, format string, args ...any) bool {
+	t.Helper()
+
+	if err == nil {
+		return true
+	}
+
+	t.Log(assert.NewErrAssertFailed(assert.ErrMsg(format, args, err)).Error())
+	t.Fail()
+
+	return false
+}
+
+// CheckNotNil reports v as a failure on t if it is nil.
+//
+// Parameters:
+//   - t: the test to report the failure on.
+//   - v: the value to check.
+//   - name: the name of the value.
+//
+// Returns:
+//   - bool: true if v is not nil, false otherwise.
+func CheckNotNil(t T, v any, name string) bool {
+	t.Helper()
+
+	if v != nil {
+		return true
+	}
+
+	t.Log(assert.NewErrAssertFailed(assert.NotNilMsg(name)).Error())
+	t.Fail()
+
+	return false
+}
+
+// CheckTypeOf reports elem as a failure on t if it is not of type T.
+//
+// Parameters:
+//   - t: the test to report the failure on.
+//   - elem: the element to check.
+//   - target: the name of the variable being checked.
+//   - allow_nil: whether elem is allowed to be nil.
+//
+// Returns:
+//   - bool: true if elem is of type T (or nil, when allowed), false otherwise.
+func CheckTypeOf[E any](t T, elem any, target string, allow_nil bool) bool {
+	t.Helper()
+
+	if elem == nil {
+		if allow_nil {
+			return true
+		}
+
+		t.Log(assert.NewErrAssertFailed(assert.TypeOfNilMsg(target, *new(E))).Error())
+		t.Fail()
+
+		return false
+	}
+
+	_, ok := elem.(E)
+	if !ok {
+		t.Log(assert.NewErrAssertFailed(assert.TypeOfMsg(target, *new(E), elem)).Error())
+		t.Fail()
+
+		return false
+	}
+
+	return true
+}
+
+// CheckDeref dereferences elem and reports a failure on t if it is nil.
+//
+// Parameters:
+//   - t: the test to report the failure on.
+//   - elem: the element to dereference.
+//   - is_param: whether elem is a parameter or a variable.
+//   - name: the name of elem.
+//
+// Returns:
+//   - E: the dereferenced element, or the zero value of E on failure.
+//   - bool: true on success, false otherwise.
+func CheckDeref[E any](t T, elem *E, is_param bool, name string) (E, bool) {
+	t.Helper()
+
+	if elem != nil {
+		return *elem, true
+	}
+
+	t.Log(assert.NewErrAssertFailed(assert.DerefMsg(is_param, name)).Error())
+	t.Fail()
+
+	return *new(E), false
+}
+
+// CheckConv tries to convert elem to type E and reports a failure on t if it
+// is not possible.
+//
+// Parameters:
+//   - t: the test to report the failure on.
+//   - elem: the element to convert.
+//   - target: the name of the variable being converted.
+//
+// Returns:
+//   - E: the converted element, or the zero value of E on failure.
+//   - bool: true on success, false otherwise.
+func CheckConv[E any](t T, elem any, target string) (E, bool) {
+	t.Helper()
+
+	if elem == nil {
+		t.Log(assert.NewErrAssertFailed(assert.TypeOfNilMsg(target, *new(E))).Error())
+		t.Fail()
+
+		return *new(E), false
+	}
+
+	res, ok := elem.(E)
+	if !ok {
+		t.Log(assert.NewErrAssertFailed(assert.TypeOfMsg(target, *new(E), elem)).Error())
+		t.Fail()
+
+		return *new(E), false
+	}
+
+	return res, true
+}
+
+// Assert is like Check, but calls t.FailNow() instead of t.Fail(), stopping
+// the calling test immediately.
+func Assert(t T, cond bool, msg string) {
+	t.Helper()
+
+	if !Check(t, cond, msg) {
+		t.FailNow()
+	}
+}
+
+// AssertF is like CheckF, but calls t.FailNow() instead of t.Fail().
+func AssertF(t T, cond bool, format string, args ...any) {
+	t.Helper()
+
+	if !CheckF(t, cond, format, args...) {
+		t.FailNow()
+	}
+}
+
+// AssertErr is like CheckErr, but calls t.FailNow() instead of t.Fail().
+func AssertErr(t T, err error, format string, args ...any) {
+	t.Helper()
+
+	if !CheckErr(t, err, format, args...) {
+		t.FailNow()
+	}
+}
+
+// AssertNotNil is like CheckNotNil, but calls t.FailNow() instead of
+// t.Fail().
+func AssertNotNil(t T, v any, name string) {
+	t.Helper()
+
+	if !CheckNotNil(t, v, name) {
+		t.FailNow()
+	}
+}
+
+// AssertTypeOf is like CheckTypeOf, but calls t.FailNow() instead of
+// t.Fail().
+func AssertTypeOf[E any](t T, elem any, target string, allow_nil bool) {
+	t.Helper()
+
+	if !CheckTypeOf[E](t, elem, target, allow_nil) {
+		t.FailNow()
+	}
+}
+
+// AssertDeref is like CheckDeref, but calls t.FailNow() instead of t.Fail().
+func AssertDeref[E any](t T, elem *E, is_param bool, name string) E {
+	t.Helper()
+
+	res, ok := CheckDeref(t, elem, is_param, name)
+	if !ok {
+		t.FailNow()
+	}
+
+	return res
+}
+
+// AssertConv is like CheckConv, but calls t.FailNow() instead of t.Fail().
+func AssertConv[E any](t T, elem any, target string) E {
+	t.Helper()
+
+	res, ok := CheckConv[E](t, elem, target)
+	if !ok {
+		t.FailNow()
+	}
+
+	return res
+}
@@ -0,0 +1,109 @@
+package check
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// fakeT is a minimal T implementation for exercising Check/Assert without a
+// real *testing.T.
+type fakeT struct {
+	failed   bool
+	messages []string
+}
+
+func (f *fakeT) Helper() {}
+
+func (f *fakeT) Log(args ...any) {
+	f.messages = append(f.messages, fmt.Sprint(args...))
+}
+
+func (f *fakeT) Fail() {
+	f.failed = true
+}
+
+// failNowPanic is recovered by runAssert to stop execution the way a real
+// *testing.T.FailNow would, without tearing down the whole test binary.
+type failNowPanic struct{}
+
+func (f *fakeT) FailNow() {
+	f.failed = true
+	panic(failNowPanic{})
+}
+
+// runAssert runs fn on a fresh fakeT, recovering from the FailNow panic it
+// raises so the caller can inspect fakeT's final state.
+func runAssert(fn func(*fakeT)) (result *fakeT) {
+	result = &fakeT{}
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(failNowPanic); !ok {
+				panic(r)
+			}
+		}
+	}()
+
+	fn(result)
+
+	return result
+}
+
+func TestCheck(t *testing.T) {
+	ft := &fakeT{}
+
+	if ok := Check(ft, true, "unused"); !ok || ft.failed {
+		t.Fatalf("Check(true) = %v, failed = %v", ok, ft.failed)
+	}
+
+	ft = &fakeT{}
+
+	if ok := Check(ft, false, "boom"); ok || !ft.failed {
+		t.Fatalf("Check(false) = %v, failed = %v", ok, ft.failed)
+	}
+}
+
+func TestCheckNotNil(t *testing.T) {
+	ft := &fakeT{}
+
+	if ok := CheckNotNil(ft, 1, "v"); !ok || ft.failed {
+		t.Fatalf("CheckNotNil(1) = %v, failed = %v", ok, ft.failed)
+	}
+
+	ft = &fakeT{}
+
+	if ok := CheckNotNil(ft, nil, "v"); ok || !ft.failed {
+		t.Fatalf("CheckNotNil(nil) = %v, failed = %v", ok, ft.failed)
+	}
+}
+
+func TestCheckTypeOf(t *testing.T) {
+	ft := &fakeT{}
+
+	if ok := CheckTypeOf[int](ft, 1, "v", false); !ok || ft.failed {
+		t.Fatalf("CheckTypeOf[int](1) = %v, failed = %v", ok, ft.failed)
+	}
+
+	ft = &fakeT{}
+
+	if ok := CheckTypeOf[int](ft, "nope", "v", false); ok || !ft.failed {
+		t.Fatalf("CheckTypeOf[int](\"nope\") = %v, failed = %v", ok, ft.failed)
+	}
+}
+
+func TestAssertNotNilStops(t *testing.T) {
+	ft := runAssert(func(ft *fakeT) { AssertNotNil(ft, nil, "v") })
+
+	if !ft.failed {
+		t.Fatalf("AssertNotNil(nil) did not fail")
+	}
+}
+
+func TestAssertErr(t *testing.T) {
+	ft := runAssert(func(ft *fakeT) { AssertErr(ft, errors.New("boom"), "op") })
+
+	if !ft.failed {
+		t.Fatalf("AssertErr(non-nil) did not fail")
+	}
+}
@@ -0,0 +1,214 @@
+// Package cmp provides composable, self-describing comparisons for use with
+// assert.Assert and assert.AssertF, so call sites don't need to hand-format
+// a message for every check.
+package cmp
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// Result is the outcome of a Comparison.
+type Result interface {
+	// Success reports whether the comparison succeeded.
+	Success() bool
+
+	// FailureMessage describes why the comparison failed. It is only
+	// meaningful when Success returns false.
+	FailureMessage() string
+}
+
+// Comparison is a deferred comparison: calling it performs the comparison
+// and returns its Result.
+type Comparison func() Result
+
+// result is the straightforward Result implementation returned by every
+// comparator in this package.
+type result struct {
+	success bool
+	message string
+}
+
+// Success implements Result.
+func (r result) Success() bool {
+	return r.success
+}
+
+// FailureMessage implements Result.
+func (r result) FailureMessage() string {
+	return r.message
+}
+
+// ResultSuccess returns a successful Result.
+func ResultSuccess() Result {
+	return result{success: true}
+}
+
+// ResultFailure returns a failed Result with a formatted message.
+func ResultFailure(format string, args ...any) Result {
+	return result{message: fmt.Sprintf(format, args...)}
+}
+
+// Equal compares got and want with ==.
+func Equal[T comparable](got, want T) Comparison {
+	return func() Result {
+		if got == want {
+			return ResultSuccess()
+		}
+
+		return ResultFailure("got %v, want %v", got, want)
+	}
+}
+
+// DeepEqual compares got and want with reflect.DeepEqual.
+func DeepEqual(got, want any) Comparison {
+	return func() Result {
+		if reflect.DeepEqual(got, want) {
+			return ResultSuccess()
+		}
+
+		return ResultFailure("got %#v, want %#v", got, want)
+	}
+}
+
+// Contains checks that collection contains elem. collection may be a
+// string (substring check), an array, a slice, or a map (key membership).
+func Contains(collection, elem any) Comparison {
+	return func() Result {
+		if s, ok := collection.(string); ok {
+			sub, ok := elem.(string)
+			if !ok {
+				return ResultFailure("cannot check string %q for non-string element %v", s, elem)
+			}
+
+			if !strings.Contains(s, sub) {
+				return ResultFailure("%q does not contain %q", s, sub)
+			}
+
+			return ResultSuccess()
+		}
+
+		v := reflect.ValueOf(collection)
+
+		switch v.Kind() {
+		case reflect.Array, reflect.Slice:
+			for i := 0; i < v.Len(); i++ {
+				if reflect.DeepEqual(v.Index(i).Interface(), elem) {
+					return ResultSuccess()
+				}
+			}
+
+			return ResultFailure("%v does not contain %v", collection, elem)
+		case reflect.Map:
+			key := reflect.ValueOf(elem)
+
+			if !key.IsValid() || !key.Type().AssignableTo(v.Type().Key()) || !v.MapIndex(key).IsValid() {
+				return ResultFailure("%v does not contain key %v", collection, elem)
+			}
+
+			return ResultSuccess()
+		default:
+			return ResultFailure("cannot check %T for containment", collection)
+		}
+	}
+}
+
+// Len checks that x (a string, array, slice, map, or chan) has length n.
+func Len(x any, n int) Comparison {
+	return func() Result {
+		v := reflect.ValueOf(x)
+
+		switch v.Kind() {
+		case reflect.String, reflect.Array, reflect.Slice, reflect.Map, reflect.Chan:
+			if v.Len() != n {
+				return ResultFailure("expected length %d, got %d", n, v.Len())
+			}
+
+			return ResultSuccess()
+		default:
+			return ResultFailure("cannot take length of %T", x)
+		}
+	}
+}
+
+// Nil checks that v is nil.
+func Nil(v any) Comparison {
+	return func() Result {
+		if v == nil {
+			return ResultSuccess()
+		}
+
+		rv := reflect.ValueOf(v)
+
+		switch rv.Kind() {
+		case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+			if rv.IsNil() {
+				return ResultSuccess()
+			}
+		}
+
+		return ResultFailure("%v is not nil", v)
+	}
+}
+
+// ErrorIs checks that errors.Is(err, target) holds.
+func ErrorIs(err, target error) Comparison {
+	return func() Result {
+		if errors.Is(err, target) {
+			return ResultSuccess()
+		}
+
+		return ResultFailure("error %v does not match target %v", err, target)
+	}
+}
+
+// ErrorContains checks that err is not nil and its message contains sub.
+func ErrorContains(err error, sub string) Comparison {
+	return func() Result {
+		if err == nil {
+			return ResultFailure("expected an error containing %q, got nil", sub)
+		}
+
+		if !strings.Contains(err.Error(), sub) {
+			return ResultFailure("error %q does not contain %q", err.Error(), sub)
+		}
+
+		return ResultSuccess()
+	}
+}
+
+// Panics checks that fn panics.
+func Panics(fn func()) Comparison {
+	return func() (res Result) {
+		defer func() {
+			if r := recover(); r == nil {
+				res = ResultFailure("expected a panic, but fn returned normally")
+			} else {
+				res = ResultSuccess()
+			}
+		}()
+
+		fn()
+
+		return
+	}
+}
+
+// Regexp checks that str matches pattern.
+func Regexp(pattern, str string) Comparison {
+	return func() Result {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return ResultFailure("invalid pattern %q: %s", pattern, err.Error())
+		}
+
+		if !re.MatchString(str) {
+			return ResultFailure("%q does not match pattern %q", str, pattern)
+		}
+
+		return ResultSuccess()
+	}
+}
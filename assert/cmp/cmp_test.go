@@ -0,0 +1,73 @@
+package cmp
+
+import "testing"
+
+func TestEqual(t *testing.T) {
+	if res := Equal(1, 1)(); !res.Success() {
+		t.Fatalf("Equal(1, 1) failed: %s", res.FailureMessage())
+	}
+
+	if res := Equal(1, 2)(); res.Success() {
+		t.Fatalf("Equal(1, 2) succeeded, want failure")
+	}
+}
+
+func TestContainsString(t *testing.T) {
+	if res := Contains("hello world", "world")(); !res.Success() {
+		t.Fatalf("Contains(%q, %q) failed: %s", "hello world", "world", res.FailureMessage())
+	}
+
+	if res := Contains("hello world", "bye")(); res.Success() {
+		t.Fatalf("Contains(%q, %q) succeeded, want failure", "hello world", "bye")
+	}
+}
+
+func TestContainsSlice(t *testing.T) {
+	if res := Contains([]int{1, 2, 3}, 2)(); !res.Success() {
+		t.Fatalf("Contains([1 2 3], 2) failed: %s", res.FailureMessage())
+	}
+
+	if res := Contains([]int{1, 2, 3}, 4)(); res.Success() {
+		t.Fatalf("Contains([1 2 3], 4) succeeded, want failure")
+	}
+}
+
+func TestContainsMap(t *testing.T) {
+	m := map[string]int{"a": 1}
+
+	if res := Contains(m, "a")(); !res.Success() {
+		t.Fatalf("Contains(m, %q) failed: %s", "a", res.FailureMessage())
+	}
+
+	if res := Contains(m, "b")(); res.Success() {
+		t.Fatalf("Contains(m, %q) succeeded, want failure", "b")
+	}
+}
+
+// TestContainsMapKeyTypeMismatch guards against the reflect.Value.MapIndex
+// panic that used to surface when the probed key's type wasn't assignable
+// to the map's key type: it should fail cleanly instead of crashing.
+func TestContainsMapKeyTypeMismatch(t *testing.T) {
+	m := map[string]int{"a": 1}
+
+	res := Contains(m, 5)()
+	if res.Success() {
+		t.Fatalf("Contains(m, 5) succeeded, want failure")
+	}
+}
+
+func TestErrorContains(t *testing.T) {
+	if res := ErrorContains(nil, "boom")(); res.Success() {
+		t.Fatalf("ErrorContains(nil, %q) succeeded, want failure", "boom")
+	}
+}
+
+func TestPanics(t *testing.T) {
+	if res := Panics(func() { panic("boom") })(); !res.Success() {
+		t.Fatalf("Panics(panicking fn) failed: %s", res.FailureMessage())
+	}
+
+	if res := Panics(func() {})(); res.Success() {
+		t.Fatalf("Panics(non-panicking fn) succeeded, want failure")
+	}
+}
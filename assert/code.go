@@ -0,0 +1,86 @@
+package assert
+
+import "errors"
+
+// AssertCode categorizes why an assertion failed, so callers can filter or
+// aggregate failures programmatically instead of string-matching messages.
+type AssertCode int
+
+const (
+	// CodeUnknown is the zero value, used for assertion failures that
+	// predate (or bypass) code tagging.
+	CodeUnknown AssertCode = iota
+
+	// CodeNil marks a failure where a value was unexpectedly nil.
+	CodeNil
+
+	// CodeType marks a failure where a value was not of the expected type.
+	CodeType
+
+	// CodeCond marks a failure of a plain boolean or comparison condition.
+	CodeCond
+
+	// CodeErr marks a failure caused by an unexpected (or missing) error.
+	CodeErr
+
+	// CodeDeref marks a failure dereferencing a nil pointer.
+	CodeDeref
+
+	// CodeContains marks a failure of a containment or equality check over
+	// a collection (string, slice, array, or map).
+	CodeContains
+
+	// CodeLen marks a failure of a length check.
+	CodeLen
+
+	// CodePanic marks a failure of a panic-recovery assertion.
+	CodePanic
+
+	// CodeTODO marks an unfinished code path reached via TODO.
+	CodeTODO
+)
+
+// String returns the human-readable name of c.
+func (c AssertCode) String() string {
+	switch c {
+	case CodeNil:
+		return "Nil"
+	case CodeType:
+		return "Type"
+	case CodeCond:
+		return "Cond"
+	case CodeErr:
+		return "Err"
+	case CodeDeref:
+		return "Deref"
+	case CodeContains:
+		return "Contains"
+	case CodeLen:
+		return "Len"
+	case CodePanic:
+		return "Panic"
+	case CodeTODO:
+		return "TODO"
+	default:
+		return "Unknown"
+	}
+}
+
+// CodeOf walks err's chain (via errors.Unwrap) looking for an
+// *ErrAssertFailed, and returns its AssertCode.
+//
+// Parameters:
+//   - err: the error to inspect.
+//
+// Returns:
+//   - AssertCode: the code of the first *ErrAssertFailed found in the chain.
+//   - bool: true if one was found, false otherwise.
+func CodeOf(err error) (AssertCode, bool) {
+	var assertErr *ErrAssertFailed
+
+	if !errors.As(err, &assertErr) {
+		return CodeUnknown, false
+	}
+
+	return assertErr.code, true
+}
@@ -0,0 +1,37 @@
+package assert
+
+import "testing"
+
+func TestAssertCodeString(t *testing.T) {
+	tests := map[AssertCode]string{
+		CodeUnknown:  "Unknown",
+		CodeNil:      "Nil",
+		CodeType:     "Type",
+		CodeCond:     "Cond",
+		CodeErr:      "Err",
+		CodeDeref:    "Deref",
+		CodeContains: "Contains",
+		CodeLen:      "Len",
+		CodePanic:    "Panic",
+		CodeTODO:     "TODO",
+	}
+
+	for code, want := range tests {
+		if got := code.String(); got != want {
+			t.Errorf("%d.String() = %q, want %q", code, got, want)
+		}
+	}
+}
+
+func TestCodeOf(t *testing.T) {
+	err := newErrAssertFailed(0, CodeContains, "boom", nil)
+
+	code, ok := CodeOf(err)
+	if !ok || code != CodeContains {
+		t.Fatalf("CodeOf(err) = (%v, %v), want (%v, true)", code, ok, CodeContains)
+	}
+
+	if _, ok := CodeOf(nil); ok {
+		t.Fatalf("CodeOf(nil) = ok, want not ok")
+	}
+}
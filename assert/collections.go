@@ -0,0 +1,411 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxRenderedLen is the maximum number of characters a rendered container is
+// allowed to take up in a failure message before it is truncated.
+const maxRenderedLen = 120
+
+// renderValue renders v for use in a failure message, truncating it if it is
+// too long to stay readable.
+func renderValue(v any) string {
+	s := fmt.Sprintf("%v", v)
+
+	if len(s) <= maxRenderedLen {
+		return s
+	}
+
+	return s[:maxRenderedLen] + "..."
+}
+
+// renderKeys renders the sorted, quoted keys of m for use in a failure
+// message.
+func renderKeys(m reflect.Value) string {
+	keys := make([]string, 0, m.Len())
+
+	for _, k := range m.MapKeys() {
+		keys = append(keys, fmt.Sprintf("%v", k.Interface()))
+	}
+
+	sort.Strings(keys)
+
+	quoted := make([]string, len(keys))
+
+	for i, k := range keys {
+		quoted[i] = strconv.Quote(k)
+	}
+
+	return "[" + strings.Join(quoted, " ") + "]"
+}
+
+// mapLookup looks up key in map m, without panicking when key's type is not
+// assignable to m's key type (reflect.Value.MapIndex panics in that case).
+func mapLookup(m, key reflect.Value) (reflect.Value, bool) {
+	if !key.IsValid() || !key.Type().AssignableTo(m.Type().Key()) {
+		return reflect.Value{}, false
+	}
+
+	v := m.MapIndex(key)
+
+	return v, v.IsValid()
+}
+
+// mapHasKey reports whether map m has key.
+func mapHasKey(m, key reflect.Value) bool {
+	_, ok := mapLookup(m, key)
+	return ok
+}
+
+// containsElem reports whether container (a string, array, slice, or map)
+// contains elem.
+func containsElem(container, elem any) bool {
+	if s, ok := container.(string); ok {
+		sub, ok := elem.(string)
+		return ok && strings.Contains(s, sub)
+	}
+
+	v := reflect.ValueOf(container)
+
+	switch v.Kind() {
+	case reflect.Array, reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			if reflect.DeepEqual(v.Index(i).Interface(), elem) {
+				return true
+			}
+		}
+
+		return false
+	case reflect.Map:
+		return mapHasKey(v, reflect.ValueOf(elem))
+	default:
+		return false
+	}
+}
+
+// AssertContains panics if container does not contain elem. container may
+// be a string (substring check), an array, a slice, or a map (key
+// membership).
+//
+// Parameters:
+//   - container: the container to check.
+//   - elem: the element expected to be found in container.
+//   - name: the name of the container.
+func AssertContains(container, elem any, name string) {
+	if containsElem(container, elem) {
+		return
+	}
+
+	msg := fmt.Sprintf("%s does not contain %s (have: %s)", strconv.Quote(name), renderValue(elem), renderValue(container))
+
+	panic(newErrAssertFailed(1, CodeContains, msg, nil))
+}
+
+// AssertNotContains panics if container contains elem.
+//
+// Parameters:
+//   - container: the container to check.
+//   - elem: the element expected to not be found in container.
+//   - name: the name of the container.
+func AssertNotContains(container, elem any, name string) {
+	if !containsElem(container, elem) {
+		return
+	}
+
+	msg := fmt.Sprintf("%s must not contain %s", strconv.Quote(name), renderValue(elem))
+
+	panic(newErrAssertFailed(1, CodeContains, msg, nil))
+}
+
+// AssertContainsKey panics if map m does not have key.
+//
+// Parameters:
+//   - m: the map to check.
+//   - key: the key expected to be found in m.
+//   - name: the name of the map.
+func AssertContainsKey(m any, key any, name string) {
+	v := reflect.ValueOf(m)
+
+	if v.Kind() != reflect.Map {
+		panic(newErrAssertFailed(1, CodeType, fmt.Sprintf("%s is not a map (got %T)", strconv.Quote(name), m), nil))
+	}
+
+	if mapHasKey(v, reflect.ValueOf(key)) {
+		return
+	}
+
+	msg := fmt.Sprintf("%s does not contain key %s (have keys: %s)", strconv.Quote(name), renderValue(key), renderKeys(v))
+
+	panic(newErrAssertFailed(1, CodeContains, msg, nil))
+}
+
+// AssertNotContainsKey panics if map m has key.
+//
+// Parameters:
+//   - m: the map to check.
+//   - key: the key expected to not be found in m.
+//   - name: the name of the map.
+func AssertNotContainsKey(m any, key any, name string) {
+	v := reflect.ValueOf(m)
+
+	if v.Kind() != reflect.Map {
+		panic(newErrAssertFailed(1, CodeType, fmt.Sprintf("%s is not a map (got %T)", strconv.Quote(name), m), nil))
+	}
+
+	if !mapHasKey(v, reflect.ValueOf(key)) {
+		return
+	}
+
+	msg := fmt.Sprintf("%s must not contain key %s", strconv.Quote(name), renderValue(key))
+
+	panic(newErrAssertFailed(1, CodeContains, msg, nil))
+}
+
+// AssertContainsKeys panics if map m is missing any of keys.
+//
+// Parameters:
+//   - m: the map to check.
+//   - keys: the keys expected to be found in m.
+//   - name: the name of the map.
+func AssertContainsKeys(m any, keys []any, name string) {
+	v := reflect.ValueOf(m)
+
+	if v.Kind() != reflect.Map {
+		panic(newErrAssertFailed(1, CodeType, fmt.Sprintf("%s is not a map (got %T)", strconv.Quote(name), m), nil))
+	}
+
+	var missing []any
+
+	for _, key := range keys {
+		if !mapHasKey(v, reflect.ValueOf(key)) {
+			missing = append(missing, key)
+		}
+	}
+
+	if len(missing) == 0 {
+		return
+	}
+
+	msg := fmt.Sprintf("%s is missing keys %s (have keys: %s)", strconv.Quote(name), renderValue(missing), renderKeys(v))
+
+	panic(newErrAssertFailed(1, CodeContains, msg, nil))
+}
+
+// AssertNotContainsKeys panics if map m has any of keys.
+//
+// Parameters:
+//   - m: the map to check.
+//   - keys: the keys expected to not be found in m.
+//   - name: the name of the map.
+func AssertNotContainsKeys(m any, keys []any, name string) {
+	v := reflect.ValueOf(m)
+
+	if v.Kind() != reflect.Map {
+		panic(newErrAssertFailed(1, CodeType, fmt.Sprintf("%s is not a map (got %T)", strconv.Quote(name), m), nil))
+	}
+
+	var present []any
+
+	for _, key := range keys {
+		if mapHasKey(v, reflect.ValueOf(key)) {
+			present = append(present, key)
+		}
+	}
+
+	if len(present) == 0 {
+		return
+	}
+
+	msg := fmt.Sprintf("%s must not contain keys %s", strconv.Quote(name), renderValue(present))
+
+	panic(newErrAssertFailed(1, CodeContains, msg, nil))
+}
+
+// lenOf returns the length of v (a string, array, slice, map, or chan) and
+// whether v's kind supports len().
+func lenOf(v any) (int, bool) {
+	rv := reflect.ValueOf(v)
+
+	switch rv.Kind() {
+	case reflect.String, reflect.Array, reflect.Slice, reflect.Map, reflect.Chan:
+		return rv.Len(), true
+	default:
+		return 0, false
+	}
+}
+
+// AssertLen panics if v does not have length n.
+//
+// Parameters:
+//   - v: the string, array, slice, map, or chan to check.
+//   - n: the expected length.
+//   - name: the name of v.
+func AssertLen(v any, n int, name string) {
+	length, ok := lenOf(v)
+	if !ok {
+		panic(newErrAssertFailed(1, CodeType, fmt.Sprintf("%s does not have a length (got %T)", strconv.Quote(name), v), nil))
+	}
+
+	if length == n {
+		return
+	}
+
+	msg := fmt.Sprintf("%s has length %d, expected %d", strconv.Quote(name), length, n)
+
+	panic(newErrAssertFailed(1, CodeLen, msg, nil))
+}
+
+// AssertNotLen panics if v has length n.
+//
+// Parameters:
+//   - v: the string, array, slice, map, or chan to check.
+//   - n: the length v must not have.
+//   - name: the name of v.
+func AssertNotLen(v any, n int, name string) {
+	length, ok := lenOf(v)
+	if !ok {
+		panic(newErrAssertFailed(1, CodeType, fmt.Sprintf("%s does not have a length (got %T)", strconv.Quote(name), v), nil))
+	}
+
+	if length != n {
+		return
+	}
+
+	msg := fmt.Sprintf("%s must not have length %d", strconv.Quote(name), n)
+
+	panic(newErrAssertFailed(1, CodeLen, msg, nil))
+}
+
+// AssertEmpty panics if v is not empty.
+//
+// Parameters:
+//   - v: the string, array, slice, map, or chan to check.
+//   - name: the name of v.
+func AssertEmpty(v any, name string) {
+	length, ok := lenOf(v)
+	if !ok {
+		panic(newErrAssertFailed(1, CodeType, fmt.Sprintf("%s does not have a length (got %T)", strconv.Quote(name), v), nil))
+	}
+
+	if length == 0 {
+		return
+	}
+
+	msg := fmt.Sprintf("%s has length %d, expected 0", strconv.Quote(name), length)
+
+	panic(newErrAssertFailed(1, CodeLen, msg, nil))
+}
+
+// AssertNotEmpty panics if v is empty.
+//
+// Parameters:
+//   - v: the string, array, slice, map, or chan to check.
+//   - name: the name of v.
+func AssertNotEmpty(v any, name string) {
+	length, ok := lenOf(v)
+	if !ok {
+		panic(newErrAssertFailed(1, CodeType, fmt.Sprintf("%s does not have a length (got %T)", strconv.Quote(name), v), nil))
+	}
+
+	if length != 0 {
+		return
+	}
+
+	msg := strconv.Quote(name) + " must not be empty"
+
+	panic(newErrAssertFailed(1, CodeLen, msg, nil))
+}
+
+// AssertMapEqual panics if map got does not equal map want, and reports a
+// line-by-line diff of missing/extra entries.
+//
+// Parameters:
+//   - got: the map under test.
+//   - want: the expected map.
+//   - name: the name of got.
+func AssertMapEqual(got, want any, name string) {
+	gv := reflect.ValueOf(got)
+	wv := reflect.ValueOf(want)
+
+	if gv.Kind() != reflect.Map || wv.Kind() != reflect.Map {
+		panic(newErrAssertFailed(1, CodeType, fmt.Sprintf("%s: both got (%T) and want (%T) must be maps", strconv.Quote(name), got, want), nil))
+	}
+
+	var diff []string
+
+	for _, k := range wv.MapKeys() {
+		wantVal := wv.MapIndex(k)
+
+		gotVal, ok := mapLookup(gv, k)
+		if !ok {
+			diff = append(diff, fmt.Sprintf("- missing key %v (want %v)", k.Interface(), wantVal.Interface()))
+		} else if !reflect.DeepEqual(gotVal.Interface(), wantVal.Interface()) {
+			diff = append(diff, fmt.Sprintf("~ key %v: got %v, want %v", k.Interface(), gotVal.Interface(), wantVal.Interface()))
+		}
+	}
+
+	for _, k := range gv.MapKeys() {
+		if _, ok := mapLookup(wv, k); !ok {
+			diff = append(diff, fmt.Sprintf("+ extra key %v (got %v)", k.Interface(), gv.MapIndex(k).Interface()))
+		}
+	}
+
+	if len(diff) == 0 {
+		return
+	}
+
+	sort.Strings(diff)
+
+	msg := fmt.Sprintf("%s does not equal expected map:\n%s", strconv.Quote(name), strings.Join(diff, "\n"))
+
+	panic(newErrAssertFailed(1, CodeContains, msg, nil))
+}
+
+// AssertSliceEqual panics if slice got does not equal slice want, and
+// reports a line-by-line diff of missing/extra entries.
+//
+// Parameters:
+//   - got: the slice under test.
+//   - want: the expected slice.
+//   - name: the name of got.
+func AssertSliceEqual(got, want any, name string) {
+	gv := reflect.ValueOf(got)
+	wv := reflect.ValueOf(want)
+
+	isSeq := func(k reflect.Kind) bool { return k == reflect.Slice || k == reflect.Array }
+
+	if !isSeq(gv.Kind()) || !isSeq(wv.Kind()) {
+		panic(newErrAssertFailed(1, CodeType, fmt.Sprintf("%s: both got (%T) and want (%T) must be slices or arrays", strconv.Quote(name), got, want), nil))
+	}
+
+	var diff []string
+
+	n := gv.Len()
+	if wv.Len() > n {
+		n = wv.Len()
+	}
+
+	for i := 0; i < n; i++ {
+		switch {
+		case i >= gv.Len():
+			diff = append(diff, fmt.Sprintf("- [%d] missing, want %v", i, wv.Index(i).Interface()))
+		case i >= wv.Len():
+			diff = append(diff, fmt.Sprintf("+ [%d] extra, got %v", i, gv.Index(i).Interface()))
+		case !reflect.DeepEqual(gv.Index(i).Interface(), wv.Index(i).Interface()):
+			diff = append(diff, fmt.Sprintf("~ [%d] got %v, want %v", i, gv.Index(i).Interface(), wv.Index(i).Interface()))
+		}
+	}
+
+	if len(diff) == 0 {
+		return
+	}
+
+	msg := fmt.Sprintf("%s does not equal expected slice:\n%s", strconv.Quote(name), strings.Join(diff, "\n"))
+
+	panic(newErrAssertFailed(1, CodeContains, msg, nil))
+}
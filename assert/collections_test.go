@@ -0,0 +1,111 @@
+package assert
+
+import (
+	"strings"
+	"testing"
+)
+
+func mustPanic(t *testing.T, fn func()) {
+	t.Helper()
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("expected a panic, but fn returned normally")
+		}
+	}()
+
+	fn()
+}
+
+func TestAssertContains(t *testing.T) {
+	AssertContains([]int{1, 2, 3}, 2, "s")
+	AssertContains(map[string]int{"a": 1}, "a", "m")
+	AssertContains("hello", "ell", "s")
+
+	mustPanic(t, func() { AssertContains([]int{1, 2, 3}, 4, "s") })
+}
+
+func TestAssertContainsKey(t *testing.T) {
+	AssertContainsKey(map[string]int{"a": 1}, "a", "m")
+
+	mustPanic(t, func() { AssertContainsKey(map[string]int{"a": 1}, "b", "m") })
+}
+
+// TestAssertContainsKeyTypeMismatch guards against the reflect.Value.MapIndex
+// panic that used to surface when the probed key's type wasn't assignable to
+// the map's key type: it should report a normal [ASSERT FAILED] instead of
+// crashing.
+func TestAssertContainsKeyTypeMismatch(t *testing.T) {
+	defer func() {
+		r := recover()
+
+		if _, ok := r.(*ErrAssertFailed); !ok {
+			t.Fatalf("recovered %v (%T), want an *ErrAssertFailed", r, r)
+		}
+	}()
+
+	AssertContainsKey(map[string]int{"a": 1}, 5, "m")
+}
+
+func TestAssertNotContainsKey(t *testing.T) {
+	AssertNotContainsKey(map[string]int{"a": 1}, "b", "m")
+	AssertNotContainsKey(map[string]int{"a": 1}, 5, "m")
+
+	mustPanic(t, func() { AssertNotContainsKey(map[string]int{"a": 1}, "a", "m") })
+}
+
+func TestAssertContainsKeys(t *testing.T) {
+	AssertContainsKeys(map[string]int{"a": 1, "b": 2}, []any{"a", "b"}, "m")
+
+	mustPanic(t, func() { AssertContainsKeys(map[string]int{"a": 1}, []any{"a", "b", 5}, "m") })
+}
+
+func TestAssertLen(t *testing.T) {
+	AssertLen([]int{1, 2, 3}, 3, "s")
+
+	mustPanic(t, func() { AssertLen([]int{1, 2, 3}, 2, "s") })
+}
+
+func TestAssertEmpty(t *testing.T) {
+	AssertEmpty([]int{}, "s")
+
+	mustPanic(t, func() { AssertEmpty([]int{1}, "s") })
+}
+
+func TestAssertNotEmpty(t *testing.T) {
+	AssertNotEmpty([]int{1}, "s")
+
+	mustPanic(t, func() { AssertNotEmpty([]int{}, "s") })
+}
+
+func TestAssertMapEqual(t *testing.T) {
+	AssertMapEqual(map[string]int{"a": 1}, map[string]int{"a": 1}, "m")
+
+	mustPanic(t, func() { AssertMapEqual(map[string]int{"a": 1}, map[string]int{"a": 2}, "m") })
+}
+
+// TestAssertMapEqualKeyTypeMismatch guards against the reflect.Value.MapIndex
+// panic that used to surface when got and want had different key types: it
+// should report every want key as missing instead of crashing.
+func TestAssertMapEqualKeyTypeMismatch(t *testing.T) {
+	defer func() {
+		r := recover()
+
+		err, ok := r.(*ErrAssertFailed)
+		if !ok {
+			t.Fatalf("recovered %v (%T), want an *ErrAssertFailed", r, r)
+		}
+
+		if !strings.Contains(err.Msg, "- missing key a (want 1)") {
+			t.Fatalf("message %q does not report key a as missing", err.Msg)
+		}
+	}()
+
+	AssertMapEqual(map[int]int{1: 1}, map[string]int{"a": 1}, "m")
+}
+
+func TestAssertSliceEqual(t *testing.T) {
+	AssertSliceEqual([]int{1, 2}, []int{1, 2}, "s")
+
+	mustPanic(t, func() { AssertSliceEqual([]int{1, 2}, []int{1, 3}, "s") })
+}
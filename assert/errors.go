@@ -0,0 +1,128 @@
+package assert
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+// ErrAssertFailed occurs when an assertion fails.
+type ErrAssertFailed struct {
+	// Msg is the message explaining why the assertion failed.
+	Msg string
+
+	// File is the path of the file the assertion was called from.
+	File string
+
+	// Line is the line number the assertion was called from.
+	Line int
+
+	// Func is the qualified name (package.Func) of the function that
+	// performed the assertion.
+	Func string
+
+	// Cause is the underlying error that triggered the assertion failure,
+	// if any.
+	Cause error
+
+	// code categorizes why the assertion failed. It is unexported so that
+	// it can only be set through this package's helpers; read it back with
+	// Code or the package-level CodeOf.
+	code AssertCode
+}
+
+// Code returns the AssertCode categorizing why the assertion failed.
+func (e *ErrAssertFailed) Code() AssertCode {
+	return e.code
+}
+
+// Error implements the error interface.
+func (e *ErrAssertFailed) Error() string {
+	var sb strings.Builder
+
+	sb.WriteString("[ASSERT FAILED]")
+
+	if e.File != "" {
+		fmt.Fprintf(&sb, " %s:%d", e.File, e.Line)
+	}
+
+	if e.Func != "" {
+		fmt.Fprintf(&sb, " in %s", e.Func)
+	}
+
+	sb.WriteString(": ")
+	sb.WriteString(e.Msg)
+
+	if e.Cause != nil {
+		sb.WriteString(": ")
+		sb.WriteString(e.Cause.Error())
+	}
+
+	return sb.String()
+}
+
+// Unwrap returns the cause of the assertion failure, allowing callers to
+// errors.Is/errors.As through it.
+func (e *ErrAssertFailed) Unwrap() error {
+	return e.Cause
+}
+
+// NewErrAssertFailed creates a new ErrAssertFailed error without caller
+// location information. It is kept for callers that build their own
+// ErrAssertFailed outside of this package's helpers; every helper in this
+// package uses newErrAssertFailed instead, so its failures carry the
+// caller's file:line.
+//
+// Parameters:
+//   - msg: the message explaining why the assertion failed.
+//
+// Returns:
+//   - *ErrAssertFailed: the new error. Never returns nil.
+func NewErrAssertFailed(msg string) *ErrAssertFailed {
+	return &ErrAssertFailed{
+		Msg: msg,
+	}
+}
+
+// newErrAssertFailed creates a new ErrAssertFailed error, capturing the
+// caller's file:line and function name.
+//
+// Parameters:
+//   - skip: the number of stack frames to skip before the caller whose
+//     location should be reported, not counting this function itself. A
+//     helper that calls newErrAssertFailed directly should pass 1, so that
+//     the reported frame is the helper's own caller.
+//   - code: the AssertCode categorizing the failure.
+//   - msg: the message explaining why the assertion failed.
+//   - cause: the underlying error that triggered the failure, if any.
+//
+// Returns:
+//   - *ErrAssertFailed: the new error. Never returns nil.
+func newErrAssertFailed(skip int, code AssertCode, msg string, cause error) *ErrAssertFailed {
+	pc, file, line, ok := runtime.Caller(skip + 1)
+
+	err := &ErrAssertFailed{
+		Msg:   msg,
+		Cause: cause,
+		code:  code,
+	}
+
+	if !ok {
+		return err
+	}
+
+	err.File = file
+	err.Line = line
+
+	if fn := runtime.FuncForPC(pc); fn != nil {
+		name := fn.Name()
+
+		if idx := strings.LastIndex(name, "/"); idx != -1 {
+			name = name[idx+1:]
+		}
+
+		err.Func = name
+	}
+
+	return err
+}
@@ -0,0 +1,48 @@
+package assert
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestNewErrAssertFailedNoLocation(t *testing.T) {
+	err := NewErrAssertFailed("boom")
+
+	if err.File != "" || err.Line != 0 || err.Func != "" {
+		t.Fatalf("NewErrAssertFailed captured location: %+v", err)
+	}
+
+	if got, want := err.Error(), "[ASSERT FAILED]: boom"; got != want {
+		t.Fatalf("Error() = %q, want %q", got, want)
+	}
+}
+
+func TestNewErrAssertFailedCapturesCaller(t *testing.T) {
+	err := newErrAssertFailed(0, CodeCond, "boom", nil)
+
+	if err.File == "" || err.Line == 0 {
+		t.Fatalf("newErrAssertFailed did not capture a caller location: %+v", err)
+	}
+
+	if !strings.HasSuffix(err.File, "errors_test.go") {
+		t.Fatalf("newErrAssertFailed captured file %q, want it to end in errors_test.go", err.File)
+	}
+
+	if !strings.Contains(err.Func, "TestNewErrAssertFailedCapturesCaller") {
+		t.Fatalf("newErrAssertFailed captured func %q, want it to name this test", err.Func)
+	}
+}
+
+func TestErrAssertFailedWrapsCause(t *testing.T) {
+	cause := errors.New("root cause")
+	err := newErrAssertFailed(0, CodeErr, "boom", cause)
+
+	if !errors.Is(err, cause) {
+		t.Fatalf("errors.Is(err, cause) = false, want true")
+	}
+
+	if !strings.HasSuffix(err.Error(), cause.Error()) {
+		t.Fatalf("Error() = %q, want it to end with the cause's message", err.Error())
+	}
+}
@@ -0,0 +1,76 @@
+package assert
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// okMsg builds the failure message for a check on a boolean value expected
+// to be true.
+func okMsg(format string, args []any) string {
+	return fmt.Sprintf(format, args...) + " = false"
+}
+
+// notOkMsg builds the failure message for a check on a boolean value
+// expected to be false.
+func notOkMsg(format string, args []any) string {
+	return fmt.Sprintf(format, args...) + " = true"
+}
+
+// ErrMsg builds the failure message for a check on an error expected to be
+// nil.
+//
+// It is exported so that the check subpackage's CheckErr/AssertErr can
+// report the exact same wording as this package's AssertErr instead of
+// hand-formatting it again.
+func ErrMsg(format string, args []any, err error) string {
+	return fmt.Sprintf(format, args...) + " = " + err.Error()
+}
+
+// NotNilMsg builds the failure message for a check on a value expected to
+// be non-nil.
+//
+// It is exported so that the check subpackage's CheckNotNil/AssertNotNil
+// can report the exact same wording as this package's AssertNotNil instead
+// of hand-formatting it again.
+func NotNilMsg(name string) string {
+	return strconv.Quote(name) + " must not be nil"
+}
+
+// DerefMsg builds the failure message for a check on a pointer expected to
+// be non-nil before dereferencing.
+//
+// It is exported so that the check subpackage's CheckDeref/AssertDeref can
+// report the exact same wording as this package's AssertDeref instead of
+// hand-formatting it again.
+func DerefMsg(isParam bool, name string) string {
+	var kind string
+
+	if isParam {
+		kind = "parameter (" + name + ")"
+	} else {
+		kind = "variable (" + name + ")"
+	}
+
+	return kind + " expected to not be nil"
+}
+
+// TypeOfNilMsg builds the failure message for a type check where the
+// checked value was nil instead of the expected type.
+//
+// It is exported so that the check subpackage's CheckTypeOf/CheckConv and
+// this package's AssertTypeOf/AssertConv report the exact same wording
+// instead of hand-formatting it again.
+func TypeOfNilMsg(target string, zero any) string {
+	return fmt.Sprintf("expected %q to be of type %T, got nil instead", target, zero)
+}
+
+// TypeOfMsg builds the failure message for a type check where the checked
+// value was of the wrong type.
+//
+// It is exported so that the check subpackage's CheckTypeOf/CheckConv and
+// this package's AssertTypeOf/AssertConv report the exact same wording
+// instead of hand-formatting it again.
+func TypeOfMsg(target string, zero, got any) string {
+	return fmt.Sprintf("expected %q to be of type %T, got %T instead", target, zero, got)
+}
@@ -0,0 +1,142 @@
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"runtime/debug"
+	"strconv"
+)
+
+// panicResult captures what happened when a function under test was run
+// under defer/recover.
+type panicResult struct {
+	// recovered is the value passed to panic, if any.
+	recovered any
+
+	// stack is the stack trace captured at the moment of recovery, pointing
+	// at the true panic site rather than the deferred handler.
+	stack []byte
+}
+
+// runRecovering runs fn, recovering from any panic it raises.
+//
+// Returns:
+//   - panicResult: details about the panic, if fn panicked.
+//   - bool: true if fn panicked, false if it returned normally.
+func runRecovering(fn func()) (res panicResult, panicked bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			panicked = true
+			res.recovered = r
+			res.stack = debug.Stack()
+		}
+	}()
+
+	fn()
+
+	return
+}
+
+// AssertPanics panics if fn returns normally instead of panicking.
+//
+// Parameters:
+//   - fn: the function to run.
+//   - name: the name of fn, used in the failure message.
+func AssertPanics(fn func(), name string) {
+	if _, panicked := runRecovering(fn); panicked {
+		return
+	}
+
+	msg := fmt.Sprintf("%s expected to panic, but returned normally", strconv.Quote(name))
+
+	panic(newErrAssertFailed(1, CodePanic, msg, nil))
+}
+
+// AssertNotPanics panics if fn panics, embedding the recovered value and
+// its stack trace in the failure message.
+//
+// Parameters:
+//   - fn: the function to run.
+//   - name: the name of fn, used in the failure message.
+func AssertNotPanics(fn func(), name string) {
+	res, panicked := runRecovering(fn)
+	if !panicked {
+		return
+	}
+
+	msg := fmt.Sprintf("%s must not panic, but panicked with %v\n%s", strconv.Quote(name), res.recovered, res.stack)
+
+	panic(newErrAssertFailed(1, CodePanic, msg, nil))
+}
+
+// AssertPanicsWith panics unless fn panics with a value matching want. If
+// want is an error and the recovered value satisfies error, they are
+// compared with errors.Is; otherwise they are compared with
+// reflect.DeepEqual.
+//
+// Parameters:
+//   - fn: the function to run.
+//   - want: the value fn is expected to panic with.
+//   - name: the name of fn, used in the failure message.
+func AssertPanicsWith(fn func(), want any, name string) {
+	res, panicked := runRecovering(fn)
+	if !panicked {
+		msg := fmt.Sprintf("%s expected to panic with %v, but returned normally", strconv.Quote(name), want)
+
+		panic(newErrAssertFailed(1, CodePanic, msg, nil))
+	}
+
+	if wantErr, ok := want.(error); ok {
+		if gotErr, ok := res.recovered.(error); ok {
+			if errors.Is(gotErr, wantErr) {
+				return
+			}
+
+			msg := fmt.Sprintf("%s panicked with %v, want %v", strconv.Quote(name), gotErr, wantErr)
+
+			panic(newErrAssertFailed(1, CodePanic, msg, gotErr))
+		}
+	}
+
+	if reflect.DeepEqual(res.recovered, want) {
+		return
+	}
+
+	msg := fmt.Sprintf("%s panicked with %v, want %v", strconv.Quote(name), res.recovered, want)
+
+	panic(newErrAssertFailed(1, CodePanic, msg, nil))
+}
+
+// AssertPanicsMatching panics unless fn panics with a value whose
+// fmt.Sprint representation matches pattern.
+//
+// Parameters:
+//   - fn: the function to run.
+//   - pattern: the regular expression the panic value must match.
+//   - name: the name of fn, used in the failure message.
+func AssertPanicsMatching(fn func(), pattern string, name string) {
+	res, panicked := runRecovering(fn)
+	if !panicked {
+		msg := fmt.Sprintf("%s expected to panic matching %q, but returned normally", strconv.Quote(name), pattern)
+
+		panic(newErrAssertFailed(1, CodePanic, msg, nil))
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		msg := fmt.Sprintf("%s: invalid pattern %q", strconv.Quote(name), pattern)
+
+		panic(newErrAssertFailed(1, CodePanic, msg, err))
+	}
+
+	got := fmt.Sprint(res.recovered)
+	if re.MatchString(got) {
+		return
+	}
+
+	msg := fmt.Sprintf("%s panicked with %q, which does not match pattern %q", strconv.Quote(name), got, pattern)
+
+	panic(newErrAssertFailed(1, CodePanic, msg, nil))
+}
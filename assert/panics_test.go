@@ -0,0 +1,39 @@
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestAssertPanics(t *testing.T) {
+	AssertPanics(func() { panic("boom") }, "fn")
+
+	mustPanic(t, func() { AssertPanics(func() {}, "fn") })
+}
+
+func TestAssertNotPanics(t *testing.T) {
+	AssertNotPanics(func() {}, "fn")
+
+	mustPanic(t, func() { AssertNotPanics(func() { panic("boom") }, "fn") })
+}
+
+func TestAssertPanicsWith(t *testing.T) {
+	AssertPanicsWith(func() { panic("boom") }, "boom", "fn")
+
+	mustPanic(t, func() { AssertPanicsWith(func() { panic("boom") }, "bang", "fn") })
+	mustPanic(t, func() { AssertPanicsWith(func() {}, "boom", "fn") })
+}
+
+func TestAssertPanicsWithErrorsIs(t *testing.T) {
+	target := errors.New("boom")
+	wrapped := fmt.Errorf("wrap: %w", target)
+
+	AssertPanicsWith(func() { panic(wrapped) }, target, "fn")
+}
+
+func TestAssertPanicsMatching(t *testing.T) {
+	AssertPanicsMatching(func() { panic("boom 42") }, `boom \d+`, "fn")
+
+	mustPanic(t, func() { AssertPanicsMatching(func() { panic("nope") }, `boom \d+`, "fn") })
+}